@@ -0,0 +1,19 @@
+// This program prints out all 936 combinations of a 5 button lock and
+// assumes that at most 2 buttons can be pressed simultaneously. This program
+// prints out the shorter combination sequences first.
+package main
+
+import (
+	"fmt"
+
+	"github.com/keep94/fivebutton/lock"
+	"github.com/keep94/itertools"
+)
+
+var fiveButtonConfig = lock.Config{NumButtons: 5, MaxAtOnce: 2}
+
+func main() {
+	for i, ks := range itertools.Enumerate(lock.Combinations(fiveButtonConfig)) {
+		fmt.Println(i+1, ks)
+	}
+}
@@ -0,0 +1,43 @@
+package lock
+
+import "testing"
+
+func count(cfg Config) int {
+	var result int
+	for range Combinations(cfg) {
+		result++
+	}
+	return result
+}
+
+func TestCombinationsCount(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+		want int
+	}{
+		{name: "5 buttons, 2 at once", cfg: Config{NumButtons: 5, MaxAtOnce: 2}, want: 936},
+		{name: "4 buttons, 2 at once", cfg: Config{NumButtons: 4, MaxAtOnce: 2}, want: 137},
+		{name: "6 buttons, 2 at once", cfg: Config{NumButtons: 6, MaxAtOnce: 2}, want: 7672},
+		{name: "4 buttons, 1 at once", cfg: Config{NumButtons: 4, MaxAtOnce: 1}, want: 65},
+		{name: "4 buttons, 3 at once", cfg: Config{NumButtons: 4, MaxAtOnce: 3}, want: 149},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := count(test.cfg); got != test.want {
+				t.Errorf("Combinations(%+v): got %d, want %d", test.cfg, got, test.want)
+			}
+		})
+	}
+}
+
+func TestCombinationsShortestFirst(t *testing.T) {
+	cfg := Config{NumButtons: 5, MaxAtOnce: 2}
+	lastLen := -1
+	for ks := range Combinations(cfg) {
+		if len(ks) < lastLen {
+			t.Fatalf("sequence lengths out of order: got %d after %d", len(ks), lastLen)
+		}
+		lastLen = len(ks)
+	}
+}
@@ -0,0 +1,47 @@
+// Package lock models a combination lock with a configurable number of
+// buttons and a configurable limit on how many buttons can be pressed
+// simultaneously. It enumerates the legal key sequences for a given
+// Config, indexes them for prefix queries with KeyTree, and parses them
+// back out of their text form.
+package lock
+
+import "iter"
+
+// Config describes the shape of a lock: how many buttons it has and how
+// many of those buttons can be pressed simultaneously in a single
+// KeyPress.
+type Config struct {
+
+	// Number of buttons in the lock.
+	NumButtons int
+
+	// Maximum number of buttons that can be pressed in a single key press.
+	MaxAtOnce int
+}
+
+// NextPresses returns all the legal next key presses for a lock governed
+// by cfg that is currently in state l.
+func (cfg Config) NextPresses(l Lock) iter.Seq[KeyPress] {
+	return func(yield func(KeyPress) bool) {
+		queue := NewQueue[KeyPress]()
+		for i := 0; i < cfg.NumButtons; i++ {
+			if !l.isPressed(i) {
+				queue.Enqueue(SingleKeyPress(i))
+			}
+		}
+		for !queue.IsEmpty() {
+			press := queue.Dequeue()
+			if !yield(press) {
+				return
+			}
+			if press.Len() == cfg.MaxAtOnce {
+				continue
+			}
+			for i := press.Highest() + 1; i < cfg.NumButtons; i++ {
+				if !l.isPressed(i) {
+					queue.Enqueue(press.Add(i))
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package lock
+
+import (
+	"math/bits"
+	"strings"
+)
+
+// KeyPress represents a key press of a lock. A KeyPress includes pressing
+// a single button e.g "3" or pressing multiple buttons at once, e.g "25".
+// The button parameter in the methods for this type is zero based. That
+// is 0 means the "1" button, 1 means the "2" button etc. KeyPress is a
+// bitmask with bit i set when button i is pressed, so the type itself
+// can represent up to 32 buttons. However, the decimal digit text format
+// used by String and ParseKeyPress has one digit per button and so only
+// round-trips for locks of up to MaxTextButtons buttons.
+type KeyPress uint32
+
+// MaxTextButtons is the largest Config.NumButtons for which the
+// String/ParseKeyPress digit format round-trips: one decimal digit
+// '1'-'9' per button.
+const MaxTextButtons = 9
+
+// SingleKeyPress returns a key press involving a single button.
+func SingleKeyPress(button int) KeyPress {
+	return 1 << uint(button)
+}
+
+// Add adds an additional button to returned KeyPress while leaving k
+// unchanged.
+func (k KeyPress) Add(button int) KeyPress {
+	return k | (1 << uint(button))
+}
+
+// Highest returns the 0 based index of the highest button pressed in k.
+func (k KeyPress) Highest() int {
+	if k == 0 {
+		panic("Highest called on zero KeyPress")
+	}
+	return bits.Len32(uint32(k)) - 1
+}
+
+// Len returns the number of simultaneously pressed buttons in k.
+func (k KeyPress) Len() int {
+	return bits.OnesCount32(uint32(k))
+}
+
+// String returns the string representation of k. e.g "3" or "25" where
+// the numerals are one based.
+func (k KeyPress) String() string {
+	var buffer strings.Builder
+	for i := 0; i < 32; i++ {
+		if k&(1<<uint(i)) != 0 {
+			buffer.WriteByte('1' + byte(i))
+		}
+	}
+	return buffer.String()
+}
@@ -0,0 +1,26 @@
+package lock
+
+import "testing"
+
+func TestKeyPress(t *testing.T) {
+	k := SingleKeyPress(4).Add(1)
+	if got, want := k.Len(), 2; got != want {
+		t.Errorf("Len(): got %d, want %d", got, want)
+	}
+	if got, want := k.Highest(), 4; got != want {
+		t.Errorf("Highest(): got %d, want %d", got, want)
+	}
+	if got, want := k.String(), "25"; got != want {
+		t.Errorf("String(): got %q, want %q", got, want)
+	}
+}
+
+func TestKeyPressHighestPanicsOnZero(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected Highest to panic on a zero KeyPress")
+		}
+	}()
+	var k KeyPress
+	k.Highest()
+}
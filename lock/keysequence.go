@@ -0,0 +1,23 @@
+package lock
+
+import "strings"
+
+// KeySequence represents an ordered sequence of key presses on a lock.
+type KeySequence []KeyPress
+
+// Append appends a KeyPress to the end of ks and returns the resulting
+// KeySequence leaving ks unchanged.
+func (ks KeySequence) Append(k KeyPress) KeySequence {
+	result := make(KeySequence, 0, len(ks)+1)
+	result = append(result, ks...)
+	return append(result, k)
+}
+
+// String converts ks to a string e.g "5-12-4"
+func (ks KeySequence) String() string {
+	parts := make([]string, len(ks))
+	for i := range ks {
+		parts[i] = ks[i].String()
+	}
+	return strings.Join(parts, "-")
+}
@@ -0,0 +1,104 @@
+package lock
+
+import "iter"
+
+// keyTreeNode is a single node in a KeyTree. terminal is true when the
+// path from the root to this node spells out a complete, valid
+// KeySequence.
+type keyTreeNode struct {
+	children map[KeyPress]*keyTreeNode
+	terminal bool
+}
+
+func newKeyTreeNode() *keyTreeNode {
+	return &keyTreeNode{children: make(map[KeyPress]*keyTreeNode)}
+}
+
+// KeyTree is a trie over KeySequence values keyed by successive
+// KeyPress values. It locates a prefix in O(len(prefix)) time, so
+// HasPrefix and Contains take O(len(ks)) time, and Walk answers
+// questions like "given the presses so far, what completions are
+// legal?" by visiting only that prefix's subtree rather than
+// re-enumerating combinations from scratch.
+type KeyTree struct {
+	root *keyTreeNode
+}
+
+// NewKeyTree returns a new, empty KeyTree.
+func NewKeyTree() *KeyTree {
+	return &KeyTree{root: newKeyTreeNode()}
+}
+
+// BuildKeyTree returns a new KeyTree populated with every sequence
+// Combinations(cfg) produces.
+func BuildKeyTree(cfg Config) *KeyTree {
+	tree := NewKeyTree()
+	for ks := range Combinations(cfg) {
+		tree.Insert(ks)
+	}
+	return tree
+}
+
+// Insert adds ks to t.
+func (t *KeyTree) Insert(ks KeySequence) {
+	node := t.root
+	for _, kp := range ks {
+		child, ok := node.children[kp]
+		if !ok {
+			child = newKeyTreeNode()
+			node.children[kp] = child
+		}
+		node = child
+	}
+	node.terminal = true
+}
+
+// Contains returns true if ks was inserted into t as a complete
+// sequence.
+func (t *KeyTree) Contains(ks KeySequence) bool {
+	node := t.find(ks)
+	return node != nil && node.terminal
+}
+
+// HasPrefix returns true if some sequence inserted into t starts with
+// ks. A ks that was itself inserted counts as a prefix of itself.
+func (t *KeyTree) HasPrefix(ks KeySequence) bool {
+	return t.find(ks) != nil
+}
+
+// Walk yields every complete sequence stored in t that starts with
+// prefix, including prefix itself if it was inserted as a complete
+// sequence.
+func (t *KeyTree) Walk(prefix KeySequence) iter.Seq[KeySequence] {
+	return func(yield func(KeySequence) bool) {
+		node := t.find(prefix)
+		if node == nil {
+			return
+		}
+		walkNode(node, prefix, yield)
+	}
+}
+
+func (t *KeyTree) find(ks KeySequence) *keyTreeNode {
+	node := t.root
+	for _, kp := range ks {
+		child, ok := node.children[kp]
+		if !ok {
+			return nil
+		}
+		node = child
+	}
+	return node
+}
+
+func walkNode(node *keyTreeNode, prefix KeySequence, yield func(KeySequence) bool) bool {
+	if node.terminal && !yield(prefix) {
+		return false
+	}
+	for kp, child := range node.children {
+		if !walkNode(child, prefix.Append(kp), yield) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,73 @@
+package lock
+
+import "testing"
+
+func TestBuildKeyTreeCompleteness(t *testing.T) {
+	cfg := Config{NumButtons: 5, MaxAtOnce: 2}
+	tree := BuildKeyTree(cfg)
+
+	var got int
+	for ks := range Combinations(cfg) {
+		if !tree.Contains(ks) {
+			t.Fatalf("tree does not contain %v", ks)
+		}
+		got++
+	}
+	if got != 936 {
+		t.Fatalf("Combinations count changed: got %d, want 936", got)
+	}
+
+	var walked int
+	for range tree.Walk(nil) {
+		walked++
+	}
+	if walked != 936 {
+		t.Errorf("Walk(nil): got %d sequences, want 936", walked)
+	}
+}
+
+func TestKeyTreeHasPrefixAndWalk(t *testing.T) {
+	cfg := Config{NumButtons: 5, MaxAtOnce: 2}
+	tree := BuildKeyTree(cfg)
+
+	prefix := KeySequence{SingleKeyPress(4), SingleKeyPress(1).Add(2)}
+	if !tree.HasPrefix(prefix) {
+		t.Fatalf("HasPrefix(%v) = false, want true", prefix)
+	}
+
+	missing := KeySequence{SingleKeyPress(4).Add(1).Add(2)}
+	if tree.HasPrefix(missing) {
+		t.Errorf("HasPrefix(%v) = true, want false", missing)
+	}
+
+	for ks := range tree.Walk(prefix) {
+		if len(ks) < len(prefix) {
+			t.Fatalf("Walk yielded %v, shorter than prefix %v", ks, prefix)
+		}
+		for i, kp := range prefix {
+			if ks[i] != kp {
+				t.Fatalf("Walk yielded %v, which does not extend prefix %v", ks, prefix)
+			}
+		}
+		if !tree.Contains(ks) {
+			t.Errorf("Walk yielded %v, which the tree does not contain as a full sequence", ks)
+		}
+	}
+}
+
+func TestKeyTreeInsertAndContains(t *testing.T) {
+	tree := NewKeyTree()
+	a := KeySequence{SingleKeyPress(0), SingleKeyPress(1)}
+	tree.Insert(a)
+
+	if !tree.Contains(a) {
+		t.Errorf("Contains(%v) = false, want true", a)
+	}
+	prefixOnly := KeySequence{SingleKeyPress(0)}
+	if tree.Contains(prefixOnly) {
+		t.Errorf("Contains(%v) = true, want false", prefixOnly)
+	}
+	if !tree.HasPrefix(prefixOnly) {
+		t.Errorf("HasPrefix(%v) = false, want true", prefixOnly)
+	}
+}
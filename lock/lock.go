@@ -0,0 +1,44 @@
+package lock
+
+import "iter"
+
+// Lock represents the state of a lock as the set of buttons that have
+// been pressed so far. The zero value of Lock represents a lock with no
+// buttons pushed.
+type Lock KeyPress
+
+// Apply applies the KeyPress kp to l and returns the resulting lock
+// while leaving l unchanged.
+func (l Lock) Apply(kp KeyPress) Lock {
+	return l | Lock(kp)
+}
+
+func (l Lock) isPressed(button int) bool {
+	return l&(1<<uint(button)) != 0
+}
+
+// State contains the state of the lock and the key presses done so far.
+type State struct {
+	Lock Lock
+	Seq  KeySequence
+}
+
+// Combinations returns all the combinations of a lock governed by cfg
+// with the shorter combination sequences coming first.
+func Combinations(cfg Config) iter.Seq[KeySequence] {
+	return func(yield func(KeySequence) bool) {
+		queue := NewQueue[State]()
+		queue.Enqueue(State{})
+		for !queue.IsEmpty() {
+			state := queue.Dequeue()
+			if !yield(state.Seq) {
+				return
+			}
+			for kp := range cfg.NextPresses(state.Lock) {
+				lock := state.Lock.Apply(kp)
+				seq := state.Seq.Append(kp)
+				queue.Enqueue(State{Lock: lock, Seq: seq})
+			}
+		}
+	}
+}
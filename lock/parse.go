@@ -0,0 +1,80 @@
+package lock
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseKeyPress parses the string representation of a KeyPress as
+// produced by KeyPress.String, e.g "3" or "25". It rejects an empty
+// chord, a digit repeated within the chord, and a digit outside the
+// '1'-'9' range. As with KeyPress.String, this format only round-trips
+// for locks of up to MaxTextButtons buttons.
+func ParseKeyPress(s string) (KeyPress, error) {
+	if s == "" {
+		return 0, fmt.Errorf("lock: empty key press")
+	}
+	var result KeyPress
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c < '1' || c > '9' {
+			return 0, fmt.Errorf("lock: invalid button digit %q in %q", c, s)
+		}
+		button := int(c - '1')
+		if result.Add(button) == result {
+			return 0, fmt.Errorf("lock: button %c pressed twice in %q", c, s)
+		}
+		result = result.Add(button)
+	}
+	return result, nil
+}
+
+// ParseKeySequence parses the string representation of a KeySequence as
+// produced by KeySequence.String, e.g "5-12-4". It returns an error if
+// any chord in s fails to parse as a KeyPress.
+func ParseKeySequence(s string) (KeySequence, error) {
+	if s == "" {
+		return KeySequence{}, nil
+	}
+	parts := strings.Split(s, "-")
+	result := make(KeySequence, len(parts))
+	for i, part := range parts {
+		kp, err := ParseKeyPress(part)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = kp
+	}
+	return result, nil
+}
+
+// ParseKeySequenceStrict works like ParseKeySequence except that it
+// additionally rejects chords exceeding cfg.MaxAtOnce, button digits
+// greater than cfg.NumButtons, and sequences that violate the
+// Config.NextPresses rule that a given button may appear in at most one
+// chord across the whole sequence. Because the text format it parses
+// only round-trips for locks of up to MaxTextButtons buttons, it
+// rejects any cfg.NumButtons beyond that.
+func ParseKeySequenceStrict(cfg Config, s string) (KeySequence, error) {
+	if cfg.NumButtons > MaxTextButtons {
+		return nil, fmt.Errorf("lock: NumButtons %d exceeds MaxTextButtons %d, the largest lock the text format round-trips for", cfg.NumButtons, MaxTextButtons)
+	}
+	ks, err := ParseKeySequence(s)
+	if err != nil {
+		return nil, err
+	}
+	var lock Lock
+	for _, kp := range ks {
+		if kp.Len() > cfg.MaxAtOnce {
+			return nil, fmt.Errorf("lock: chord %q presses more than %d buttons at once", kp, cfg.MaxAtOnce)
+		}
+		if kp.Highest() >= cfg.NumButtons {
+			return nil, fmt.Errorf("lock: chord %q references a button beyond %d buttons", kp, cfg.NumButtons)
+		}
+		if lock&Lock(kp) != 0 {
+			return nil, fmt.Errorf("lock: sequence %q presses a button that was already pressed", s)
+		}
+		lock = lock.Apply(kp)
+	}
+	return ks, nil
+}
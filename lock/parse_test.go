@@ -0,0 +1,131 @@
+package lock
+
+import "testing"
+
+func TestParseKeyPress(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    KeyPress
+		wantErr bool
+	}{
+		{name: "single digit", s: "3", want: SingleKeyPress(2)},
+		{name: "chord", s: "25", want: SingleKeyPress(1).Add(4)},
+		{name: "chord order independent", s: "52", want: SingleKeyPress(1).Add(4)},
+		{name: "empty", s: "", wantErr: true},
+		{name: "duplicate digit", s: "55", wantErr: true},
+		{name: "digit zero", s: "05", wantErr: true},
+		{name: "non digit", s: "5x", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseKeyPress(test.s)
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("ParseKeyPress(%q): got nil error, want error", test.s)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseKeyPress(%q): got error %v, want nil", test.s, err)
+			}
+			if got != test.want {
+				t.Errorf("ParseKeyPress(%q): got %v, want %v", test.s, got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseKeySequence(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    KeySequence
+		wantErr bool
+	}{
+		{name: "empty sequence", s: "", want: KeySequence{}},
+		{name: "multiple chords", s: "5-12-4", want: KeySequence{
+			SingleKeyPress(4), SingleKeyPress(0).Add(1), SingleKeyPress(3),
+		}},
+		{name: "bad chord", s: "5-99", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseKeySequence(test.s)
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("ParseKeySequence(%q): got nil error, want error", test.s)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseKeySequence(%q): got error %v, want nil", test.s, err)
+			}
+			if got.String() != test.want.String() {
+				t.Errorf("ParseKeySequence(%q): got %v, want %v", test.s, got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseKeySequenceStrict(t *testing.T) {
+	cfg := Config{NumButtons: 5, MaxAtOnce: 2}
+	tests := []struct {
+		name    string
+		s       string
+		wantErr bool
+	}{
+		{name: "valid", s: "5-12-4"},
+		{name: "chord exceeds MaxAtOnce", s: "123", wantErr: true},
+		{name: "button beyond NumButtons", s: "6", wantErr: true},
+		{name: "button repeated across chords", s: "1-12", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseKeySequenceStrict(cfg, test.s)
+			if test.wantErr && err == nil {
+				t.Fatalf("ParseKeySequenceStrict(%q): got nil error, want error", test.s)
+			}
+			if !test.wantErr && err != nil {
+				t.Fatalf("ParseKeySequenceStrict(%q): got error %v, want nil", test.s, err)
+			}
+		})
+	}
+}
+
+func TestParseKeySequenceRoundTrip(t *testing.T) {
+	cfg := Config{NumButtons: 5, MaxAtOnce: 2}
+	for ks := range Combinations(cfg) {
+		s := ks.String()
+		got, err := ParseKeySequenceStrict(cfg, s)
+		if err != nil {
+			t.Fatalf("ParseKeySequenceStrict(%q) failed: %v", s, err)
+		}
+		if got.String() != s {
+			t.Errorf("round trip mismatch: parsed %v from %q, want %v", got, s, ks)
+		}
+	}
+}
+
+func TestParseKeySequenceRoundTripAtMaxTextButtons(t *testing.T) {
+	cfg := Config{NumButtons: MaxTextButtons, MaxAtOnce: 2}
+	ks := KeySequence{SingleKeyPress(8), SingleKeyPress(0).Add(3)}
+	s := ks.String()
+	if s != "9-14" {
+		t.Fatalf("KeySequence.String() = %q, want %q", s, "9-14")
+	}
+	got, err := ParseKeySequenceStrict(cfg, s)
+	if err != nil {
+		t.Fatalf("ParseKeySequenceStrict(%q) failed: %v", s, err)
+	}
+	if got.String() != s {
+		t.Errorf("round trip mismatch: parsed %v from %q, want %v", got, s, ks)
+	}
+}
+
+func TestParseKeySequenceStrictRejectsTooManyButtons(t *testing.T) {
+	cfg := Config{NumButtons: MaxTextButtons + 1, MaxAtOnce: 2}
+	if _, err := ParseKeySequenceStrict(cfg, "1"); err == nil {
+		t.Errorf("ParseKeySequenceStrict with NumButtons %d: got nil error, want error", cfg.NumButtons)
+	}
+}
@@ -0,0 +1,40 @@
+package lock
+
+type node[T any] struct {
+	value T
+	next  *node[T]
+}
+
+// Queue represents a FIFO queue.
+type Queue[T any] struct {
+	front *node[T]
+	back  *node[T]
+}
+
+func NewQueue[T any]() *Queue[T] {
+	n := &node[T]{}
+	return &Queue[T]{front: n, back: n}
+}
+
+// IsEmpty returns true if q is empty.
+func (q *Queue[T]) IsEmpty() bool {
+	return (q.front == q.back)
+}
+
+// Enqueue adds a new value to the end of q.
+func (q *Queue[T]) Enqueue(value T) {
+	q.back.value = value
+	n := &node[T]{}
+	q.back.next = n
+	q.back = n
+}
+
+// Dequeue pops the first value off the beginning of q.
+func (q *Queue[T]) Dequeue() T {
+	if q.IsEmpty() {
+		panic("Queue already empty")
+	}
+	result := q.front.value
+	q.front = q.front.next
+	return result
+}
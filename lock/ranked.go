@@ -0,0 +1,104 @@
+package lock
+
+import (
+	"container/heap"
+	"iter"
+	"math/bits"
+)
+
+// Cost assigns a numeric difficulty to a single KeyPress.
+type Cost func(KeyPress) float64
+
+// LengthCost assigns every KeyPress a cost of 1, so the accumulated
+// cost of a KeySequence is just its length. CombinationsRanked(cfg,
+// LengthCost) yields the same sequences in the same order as
+// Combinations(cfg).
+func LengthCost(KeyPress) float64 {
+	return 1
+}
+
+// DefaultCost is the default Cost used to rank combinations by
+// ergonomics. It penalizes chords with more simultaneously pressed
+// buttons, and further penalizes chords whose buttons are not adjacent,
+// since widely spaced simultaneous presses are physically harder than
+// presses on neighboring buttons.
+func DefaultCost(kp KeyPress) float64 {
+	n := kp.Len()
+	cost := float64(n) * float64(n)
+	if n > 1 && !isAdjacentChord(kp) {
+		cost++
+	}
+	return cost
+}
+
+func isAdjacentChord(kp KeyPress) bool {
+	lowest := bits.TrailingZeros32(uint32(kp))
+	return kp.Highest()-lowest+1 == kp.Len()
+}
+
+// rankedState is a single entry in the CombinationsRanked search
+// frontier.
+type rankedState struct {
+	state State
+	cost  float64
+	order uint64
+}
+
+// rankedHeap is a min-heap of rankedState ordered by ascending cost,
+// with ties broken by insertion order so that, for equal-cost states,
+// earlier-discovered states are yielded first.
+type rankedHeap []*rankedState
+
+func (h rankedHeap) Len() int { return len(h) }
+
+func (h rankedHeap) Less(i, j int) bool {
+	if h[i].cost != h[j].cost {
+		return h[i].cost < h[j].cost
+	}
+	return h[i].order < h[j].order
+}
+
+func (h rankedHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *rankedHeap) Push(x any) {
+	*h = append(*h, x.(*rankedState))
+}
+
+func (h *rankedHeap) Pop() any {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return item
+}
+
+// CombinationsRanked returns all the combinations of a lock governed by
+// cfg together with their accumulated cost, ordered by ascending cost
+// rather than strictly by length. It explores the search space as a
+// best-first search: a state's cost is the sum of cost(kp) for every
+// KeyPress kp in its sequence so far.
+func CombinationsRanked(cfg Config, cost Cost) iter.Seq2[KeySequence, float64] {
+	return func(yield func(KeySequence, float64) bool) {
+		var order uint64
+		h := &rankedHeap{{state: State{}, cost: 0, order: order}}
+		order++
+		heap.Init(h)
+		for h.Len() > 0 {
+			item := heap.Pop(h).(*rankedState)
+			if !yield(item.state.Seq, item.cost) {
+				return
+			}
+			for kp := range cfg.NextPresses(item.state.Lock) {
+				lock := item.state.Lock.Apply(kp)
+				seq := item.state.Seq.Append(kp)
+				heap.Push(h, &rankedState{
+					state: State{Lock: lock, Seq: seq},
+					cost:  item.cost + cost(kp),
+					order: order,
+				})
+				order++
+			}
+		}
+	}
+}
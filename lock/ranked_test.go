@@ -0,0 +1,56 @@
+package lock
+
+import "testing"
+
+func TestCombinationsRankedLengthCostMatchesCombinations(t *testing.T) {
+	cfg := Config{NumButtons: 5, MaxAtOnce: 2}
+
+	var ranked []KeySequence
+	for ks := range CombinationsRanked(cfg, LengthCost) {
+		ranked = append(ranked, ks)
+	}
+
+	var plain []KeySequence
+	for ks := range Combinations(cfg) {
+		plain = append(plain, ks)
+	}
+
+	if len(ranked) != len(plain) {
+		t.Fatalf("got %d ranked sequences, want %d", len(ranked), len(plain))
+	}
+	for i := range plain {
+		if ranked[i].String() != plain[i].String() {
+			t.Fatalf("sequence %d: got %v, want %v", i, ranked[i], plain[i])
+		}
+	}
+}
+
+func TestCombinationsRankedDefaultCost(t *testing.T) {
+	cfg := Config{NumButtons: 5, MaxAtOnce: 2}
+
+	var count int
+	lastCost := -1.0
+	for _, cost := range CombinationsRanked(cfg, DefaultCost) {
+		if cost < lastCost {
+			t.Fatalf("cost decreased: got %v after %v", cost, lastCost)
+		}
+		lastCost = cost
+		count++
+	}
+	if count != 936 {
+		t.Errorf("got %d sequences, want 936", count)
+	}
+}
+
+func TestDefaultCostPenalizesChordsAndNonAdjacency(t *testing.T) {
+	single := DefaultCost(SingleKeyPress(0))
+	adjacent := DefaultCost(SingleKeyPress(0).Add(1))
+	nonAdjacent := DefaultCost(SingleKeyPress(0).Add(3))
+
+	if adjacent <= single {
+		t.Errorf("adjacent chord cost %v should exceed single press cost %v", adjacent, single)
+	}
+	if nonAdjacent <= adjacent {
+		t.Errorf("non-adjacent chord cost %v should exceed adjacent chord cost %v", nonAdjacent, adjacent)
+	}
+}